@@ -6,12 +6,56 @@ import "net/http"
 // Middleware defines a function to process middleware
 type Middleware func(http.HandlerFunc) http.HandlerFunc
 
-// Chain applies multiple middlewares to a http.HandlerFunc and returns the final http.HandlerFunc
-func Chain(h http.HandlerFunc, middlewares ...Middleware) (aggregateHandler http.HandlerFunc) {
+// ChainBuilder is an immutable, ordered sequence of middlewares. It lets
+// callers assemble a base set of middlewares once and derive per-route or
+// per-group variants from it without re-specifying the full list, similar to
+// the subrouter pattern found in gorilla/mux.
+//
+// A ChainBuilder is never mutated in place: Append and Extend both return a
+// new value, leaving the receiver untouched. The zero value is an empty
+// chain ready to use.
+type ChainBuilder struct {
+	middlewares []Middleware
+}
+
+// New creates a ChainBuilder from the given middlewares. The order matches
+// Chain: New(m1, m2).Then(h) behaves as m1(m2(h)).
+func New(middlewares ...Middleware) ChainBuilder {
+	return ChainBuilder{middlewares: append([]Middleware(nil), middlewares...)}
+}
+
+// Then applies the chain's middlewares to h and returns the resulting handler.
+func (c ChainBuilder) Then(h http.HandlerFunc) (aggregateHandler http.HandlerFunc) {
 	aggregateHandler = h
 
-	for i := len(middlewares) - 1; i >= 0; i-- {
-		aggregateHandler = middlewares[i](aggregateHandler)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		aggregateHandler = c.middlewares[i](aggregateHandler)
 	}
 	return
 }
+
+// ThenFunc is a convenience wrapper around Then for a plain handler function.
+func (c ChainBuilder) ThenFunc(h func(http.ResponseWriter, *http.Request)) http.HandlerFunc {
+	return c.Then(h)
+}
+
+// Append returns a new ChainBuilder with mws added after the receiver's
+// existing middlewares. The receiver is left unmodified.
+func (c ChainBuilder) Append(mws ...Middleware) ChainBuilder {
+	combined := make([]Middleware, 0, len(c.middlewares)+len(mws))
+	combined = append(combined, c.middlewares...)
+	combined = append(combined, mws...)
+	return ChainBuilder{middlewares: combined}
+}
+
+// Extend returns a new ChainBuilder with other's middlewares added after the
+// receiver's existing middlewares, enabling composition of sub-chains, e.g. a
+// base chain extended per route group.
+func (c ChainBuilder) Extend(other ChainBuilder) ChainBuilder {
+	return c.Append(other.middlewares...)
+}
+
+// Chain applies multiple middlewares to a http.HandlerFunc and returns the final http.HandlerFunc
+func Chain(h http.HandlerFunc, middlewares ...Middleware) http.HandlerFunc {
+	return New(middlewares...).Then(h)
+}
@@ -69,3 +69,127 @@ func TestChain(t *testing.T) {
 		})
 	}
 }
+
+func TestChainBuilderOrderingMatchesChain(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	m1 := prefixMiddleware("First -->")
+	m2 := prefixMiddleware("Second -->")
+	m3 := prefixMiddleware("Third -->")
+
+	want := executeRequest(Chain(handler, m1, m2, m3))
+	got := executeRequest(New(m1, m2, m3).Then(handler))
+
+	if got != want {
+		t.Errorf("ChainBuilder.Then() = %q, want %q", got, want)
+	}
+}
+
+func TestChainBuilderAppendDoesNotMutateParent(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	m1 := prefixMiddleware("First -->")
+	m2 := prefixMiddleware("Second -->")
+
+	base := New(m1)
+	extended := base.Append(m2)
+
+	gotBase := executeRequest(base.Then(handler))
+	gotExtended := executeRequest(extended.Then(handler))
+
+	wantBase := "First -->Handler"
+	wantExtended := "First -->Second -->Handler"
+
+	if gotBase != wantBase {
+		t.Errorf("base.Then() = %q, want %q", gotBase, wantBase)
+	}
+	if gotExtended != wantExtended {
+		t.Errorf("extended.Then() = %q, want %q", gotExtended, wantExtended)
+	}
+}
+
+func TestChainBuilderExtend(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	m1 := prefixMiddleware("First -->")
+	m2 := prefixMiddleware("Second -->")
+	m3 := prefixMiddleware("Third -->")
+
+	base := New(m1, m2)
+	sub := New(m3)
+
+	got := executeRequest(base.Extend(sub).Then(handler))
+	want := "First -->Second -->Third -->Handler"
+
+	if got != want {
+		t.Errorf("base.Extend(sub).Then() = %q, want %q", got, want)
+	}
+	// base itself must remain unaffected by the Extend call.
+	if got := executeRequest(base.Then(handler)); got != "First -->Second -->Handler" {
+		t.Errorf("base.Then() after Extend = %q, want unaffected base", got)
+	}
+}
+
+// discardResponseWriter is a minimal http.ResponseWriter that throws away
+// everything written to it, so benchmarks measure chain overhead rather than
+// httptest.NewRecorder's own allocations.
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
+
+// BenchmarkChainVsHandRolled compares a 6-deep Chain against the equivalent
+// hand-nested closures, to validate Chain adds no meaningful allocation
+// overhead of its own.
+func BenchmarkChainVsHandRolled(b *testing.B) {
+	base := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	passthrough := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			next(w, r)
+		}
+	}
+
+	b.Run("Chain6Deep", func(b *testing.B) {
+		chained := Chain(base,
+			passthrough, passthrough, passthrough,
+			passthrough, passthrough, passthrough,
+		)
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		w := &discardResponseWriter{header: make(http.Header)}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			chained(w, r)
+		}
+	})
+
+	b.Run("HandRolled6Deep", func(b *testing.B) {
+		// Six literal nested closures, built once, matching the depth of
+		// Chain6Deep's six passthrough middlewares.
+		l6 := func(w http.ResponseWriter, r *http.Request) { base(w, r) }
+		l5 := func(w http.ResponseWriter, r *http.Request) { l6(w, r) }
+		l4 := func(w http.ResponseWriter, r *http.Request) { l5(w, r) }
+		l3 := func(w http.ResponseWriter, r *http.Request) { l4(w, r) }
+		l2 := func(w http.ResponseWriter, r *http.Request) { l3(w, r) }
+		handRolled := func(w http.ResponseWriter, r *http.Request) { l2(w, r) }
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		w := &discardResponseWriter{header: make(http.Header)}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			handRolled(w, r)
+		}
+	})
+}
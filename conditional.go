@@ -0,0 +1,46 @@
+package middlewarechain
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Identity is a no-op Middleware that passes the request straight through.
+// It is useful as the "do nothing" branch of a conditional middleware, so
+// callers can compose a chain without special-casing the skip path.
+func Identity(next http.HandlerFunc) http.HandlerFunc {
+	return next
+}
+
+// When returns a Middleware that applies mw only when pred returns true for
+// the current request, and falls through to the next handler otherwise.
+// pred is evaluated per-request, not once at chain-build time, so it can
+// depend on request state such as the path or headers.
+func When(pred func(*http.Request) bool, mw Middleware) Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		wrapped := mw(next)
+		return func(w http.ResponseWriter, r *http.Request) {
+			if pred(r) {
+				wrapped(w, r)
+				return
+			}
+			next(w, r)
+		}
+	}
+}
+
+// Unless returns a Middleware that applies mw whenever pred returns false for
+// the current request. It is the complement of When.
+func Unless(pred func(*http.Request) bool, mw Middleware) Middleware {
+	return When(func(r *http.Request) bool { return !pred(r) }, mw)
+}
+
+// PathPrefix returns a Middleware that applies mw only to requests whose URL
+// path starts with prefix, bringing the gorilla/mux
+// PathPrefix().Subrouter().Use(...) scoping pattern into a router-agnostic
+// form usable with a single Chain call.
+func PathPrefix(prefix string, mw Middleware) Middleware {
+	return When(func(r *http.Request) bool {
+		return strings.HasPrefix(r.URL.Path, prefix)
+	}, mw)
+}
@@ -0,0 +1,98 @@
+package middlewarechain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func executeRequestWithPath(handler http.Handler, path string) string {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, path, nil)
+	handler.ServeHTTP(w, r)
+
+	return w.Body.String()
+}
+
+func TestWhenAppliesMiddlewareOnlyWhenPredicateTrue(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	isAPI := func(r *http.Request) bool { return r.URL.Path == "/api" }
+	mw := When(isAPI, prefixMiddleware("Auth -->"))
+
+	got := executeRequestWithPath(Chain(handler, mw), "/api")
+	if want := "Auth -->Handler"; got != want {
+		t.Errorf("path /api: got %q, want %q", got, want)
+	}
+
+	got = executeRequestWithPath(Chain(handler, mw), "/public")
+	if want := "Handler"; got != want {
+		t.Errorf("path /public: got %q, want %q", got, want)
+	}
+}
+
+func TestUnlessIsComplementOfWhen(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	isPublic := func(r *http.Request) bool { return r.URL.Path == "/public" }
+	mw := Unless(isPublic, prefixMiddleware("Auth -->"))
+
+	got := executeRequestWithPath(Chain(handler, mw), "/public")
+	if want := "Handler"; got != want {
+		t.Errorf("path /public: got %q, want %q", got, want)
+	}
+
+	got = executeRequestWithPath(Chain(handler, mw), "/private")
+	if want := "Auth -->Handler"; got != want {
+		t.Errorf("path /private: got %q, want %q", got, want)
+	}
+}
+
+func TestPathPrefixScopesMiddleware(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	mw := PathPrefix("/api", prefixMiddleware("Auth -->"))
+
+	got := executeRequestWithPath(Chain(handler, mw), "/api/users")
+	if want := "Auth -->Handler"; got != want {
+		t.Errorf("path /api/users: got %q, want %q", got, want)
+	}
+
+	got = executeRequestWithPath(Chain(handler, mw), "/home")
+	if want := "Handler"; got != want {
+		t.Errorf("path /home: got %q, want %q", got, want)
+	}
+}
+
+func TestIdentityIsNoOp(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+
+	got := executeRequest(Chain(handler, Identity))
+	if want := "Handler"; got != want {
+		t.Errorf("Identity chain = %q, want %q", got, want)
+	}
+}
+
+func TestWhenEvaluatesPredicatePerRequest(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+	var calls int
+	pred := func(r *http.Request) bool {
+		calls++
+		return r.URL.Path == "/secure"
+	}
+	chained := Chain(handler, When(pred, prefixMiddleware("Auth -->")))
+
+	executeRequestWithPath(chained, "/secure")
+	executeRequestWithPath(chained, "/open")
+
+	if calls != 2 {
+		t.Errorf("predicate evaluated %d times, want 2 (once per request)", calls)
+	}
+}
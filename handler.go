@@ -0,0 +1,37 @@
+package middlewarechain
+
+import "net/http"
+
+// HandlerMiddleware defines a middleware expressed in terms of http.Handler
+// rather than http.HandlerFunc. It is the dominant middleware signature used
+// by routers and third-party middleware (chi, gorilla, stdlib helpers like
+// http.StripPrefix and http.TimeoutHandler), so it lets those pieces compose
+// with this package without manual adapter boilerplate.
+type HandlerMiddleware func(http.Handler) http.Handler
+
+// ChainHandler applies multiple HandlerMiddlewares to h and returns the
+// resulting http.Handler. It mirrors Chain, but for the http.Handler signature.
+func ChainHandler(h http.Handler, middlewares ...HandlerMiddleware) (aggregateHandler http.Handler) {
+	aggregateHandler = h
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		aggregateHandler = middlewares[i](aggregateHandler)
+	}
+	return
+}
+
+// ToHandlerMiddleware adapts a Middleware to a HandlerMiddleware, so it can be
+// used alongside third-party http.Handler-based middlewares in a single chain.
+func ToHandlerMiddleware(mw Middleware) HandlerMiddleware {
+	return func(next http.Handler) http.Handler {
+		return mw(next.ServeHTTP)
+	}
+}
+
+// FromHandlerMiddleware adapts a HandlerMiddleware to a Middleware, so an
+// http.Handler-based middleware can be used in a HandlerFunc-based Chain.
+func FromHandlerMiddleware(mw HandlerMiddleware) Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return mw(next).ServeHTTP
+	}
+}
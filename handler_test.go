@@ -0,0 +1,76 @@
+package middlewarechain
+
+import (
+	"net/http"
+	"testing"
+)
+
+func prefixHandlerMiddleware(prefix string) HandlerMiddleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(prefix))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestChainHandler(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	})
+
+	tests := []struct {
+		name       string
+		middleware []HandlerMiddleware
+		want       string
+	}{
+		{
+			name:       "no middleware",
+			middleware: nil,
+			want:       "Handler",
+		},
+		{
+			name: "two middlewares",
+			middleware: []HandlerMiddleware{
+				prefixHandlerMiddleware("First -->"),
+				prefixHandlerMiddleware("Second -->"),
+			},
+			want: "First -->Second -->Handler",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := executeRequest(ChainHandler(handler, tt.middleware...))
+
+			if got != tt.want {
+				t.Errorf("ChainHandler() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdapterRoundTrip(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("Handler"))
+	}
+
+	hmw := prefixHandlerMiddleware("HandlerMW -->")
+	mw := prefixMiddleware("FuncMW -->")
+
+	// Mix both styles in a single Chain via the adapters.
+	got := executeRequest(Chain(handler, FromHandlerMiddleware(hmw), mw))
+	want := "HandlerMW -->FuncMW -->Handler"
+
+	if got != want {
+		t.Errorf("Chain with FromHandlerMiddleware() = %q, want %q", got, want)
+	}
+
+	// And the reverse: a Middleware used inside a ChainHandler.
+	got = executeRequest(ChainHandler(http.HandlerFunc(handler), ToHandlerMiddleware(mw)))
+	want = "FuncMW -->Handler"
+
+	if got != want {
+		t.Errorf("ChainHandler with ToHandlerMiddleware() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+package middlewares
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// compressWriter wraps an http.ResponseWriter, transparently compressing the
+// body with the wrapped io.WriteCloser and setting Content-Encoding once the
+// downstream handler's content type has been checked against the allow-list.
+type compressWriter struct {
+	http.ResponseWriter
+	writer      io.WriteCloser
+	encoding    string
+	allowed     []string
+	wroteHeader bool
+	compressing bool
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if contentTypeAllowed(w.allowed, w.Header().Get("Content-Type")) {
+			w.compressing = true
+			w.Header().Set("Content-Encoding", w.encoding)
+			w.Header().Del("Content-Length")
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.compressing {
+		return w.writer.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *compressWriter) Close() error {
+	if w.compressing {
+		return w.writer.Close()
+	}
+	return nil
+}
+
+// preferredEncoding parses an Accept-Encoding header and returns whichever of
+// "gzip" or "deflate" carries the highest q-value, honoring an explicit
+// ";q=0" as a refusal. It returns "" if neither is acceptable.
+func preferredEncoding(acceptEncoding string) string {
+	best := ""
+	bestQ := 0.0
+
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, q := parseEncodingPreference(part)
+		if name != "gzip" && name != "deflate" {
+			continue
+		}
+		if q > bestQ {
+			bestQ = q
+			best = name
+		}
+	}
+	return best
+}
+
+func parseEncodingPreference(part string) (name string, q float64) {
+	name, q = strings.TrimSpace(part), 1.0
+
+	if idx := strings.Index(name, ";"); idx != -1 {
+		params := name[idx+1:]
+		name = strings.TrimSpace(name[:idx])
+
+		for _, p := range strings.Split(params, ";") {
+			v, ok := strings.CutPrefix(strings.TrimSpace(p), "q=")
+			if !ok {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+	}
+	return name, q
+}
+
+func contentTypeAllowed(allowed []string, contentType string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if strings.HasPrefix(contentType, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// Compress returns a Middleware that compresses the response body with
+// gzip or deflate, whichever the client's Accept-Encoding header prefers,
+// restricted to the given content-type allow-list (an empty allow-list
+// compresses every content type).
+func Compress(allowedContentTypes ...string) middlewarechain.Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			encoding := preferredEncoding(r.Header.Get("Accept-Encoding"))
+
+			var writer io.WriteCloser
+			switch encoding {
+			case "gzip":
+				writer = gzip.NewWriter(w)
+			case "deflate":
+				writer, _ = flate.NewWriter(w, flate.DefaultCompression)
+			default:
+				next(w, r)
+				return
+			}
+
+			cw := &compressWriter{
+				ResponseWriter: w,
+				writer:         writer,
+				encoding:       encoding,
+				allowed:        allowedContentTypes,
+			}
+			defer cw.Close()
+			next(cw, r)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package middlewares
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestCompress(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("hello, world"))
+	}
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		allowedTypes   []string
+		wantEncoding   string
+	}{
+		{
+			name:           "gzip negotiated",
+			acceptEncoding: "gzip",
+			wantEncoding:   "gzip",
+		},
+		{
+			name:           "no compression without Accept-Encoding",
+			acceptEncoding: "",
+			wantEncoding:   "",
+		},
+		{
+			name:           "content type not allow-listed",
+			acceptEncoding: "gzip",
+			allowedTypes:   []string{"application/json"},
+			wantEncoding:   "",
+		},
+		{
+			name:           "gzip explicitly refused via q=0 falls back to deflate",
+			acceptEncoding: "gzip;q=0, deflate;q=1",
+			wantEncoding:   "deflate",
+		},
+		{
+			name:           "all encodings refused via q=0",
+			acceptEncoding: "gzip;q=0, deflate;q=0",
+			wantEncoding:   "",
+		},
+		{
+			name:           "higher q-value wins regardless of header order",
+			acceptEncoding: "deflate;q=0.5, gzip;q=0.8",
+			wantEncoding:   "gzip",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chained := middlewarechain.Chain(handler, Compress(tt.allowedTypes...))
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			chained(w, r)
+
+			if got := w.Header().Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.wantEncoding)
+			}
+
+			if tt.wantEncoding == "gzip" {
+				gz, err := gzip.NewReader(w.Body)
+				if err != nil {
+					t.Fatalf("gzip.NewReader: %v", err)
+				}
+				body, err := io.ReadAll(gz)
+				if err != nil {
+					t.Fatalf("reading gzip body: %v", err)
+				}
+				if string(body) != "hello, world" {
+					t.Errorf("decompressed body = %q, want %q", body, "hello, world")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// CORSConfig configures the CORS middleware.
+type CORSConfig struct {
+	// AllowedOrigins is the set of origins allowed to make cross-origin
+	// requests. "*" allows any origin.
+	AllowedOrigins []string
+	// AllowedMethods is the set of HTTP methods allowed for cross-origin
+	// requests. Defaults to GET, POST, HEAD if empty.
+	AllowedMethods []string
+	// AllowedHeaders is the set of request headers allowed via
+	// Access-Control-Request-Headers.
+	AllowedHeaders []string
+	// AllowCredentials sets Access-Control-Allow-Credentials.
+	AllowCredentials bool
+	// MaxAge sets Access-Control-Max-Age, in seconds. Zero omits the header.
+	MaxAge int
+}
+
+// CORS returns a Middleware that applies cfg to every request, answering
+// preflight OPTIONS requests directly and setting the appropriate
+// Access-Control-* headers on actual requests.
+func CORS(cfg CORSConfig) middlewarechain.Middleware {
+	methods := cfg.AllowedMethods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet, http.MethodPost, http.MethodHead}
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin != "" && originAllowed(cfg.AllowedOrigins, origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				if cfg.AllowCredentials {
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				}
+				w.Header().Add("Vary", "Origin")
+			}
+
+			if r.Method == http.MethodOptions {
+				w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ", "))
+				if len(cfg.AllowedHeaders) > 0 {
+					w.Header().Set("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+				}
+				if cfg.MaxAge > 0 {
+					w.Header().Set("Access-Control-Max-Age", strconv.Itoa(cfg.MaxAge))
+				}
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	for _, a := range allowed {
+		if a == "*" || a == origin {
+			return true
+		}
+	}
+	return false
+}
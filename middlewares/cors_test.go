@@ -0,0 +1,75 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestCORS(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	tests := []struct {
+		name       string
+		cfg        CORSConfig
+		method     string
+		origin     string
+		wantOrigin string
+		wantStatus int
+	}{
+		{
+			name:       "allowed origin on a simple request",
+			cfg:        CORSConfig{AllowedOrigins: []string{"https://example.com"}},
+			method:     http.MethodGet,
+			origin:     "https://example.com",
+			wantOrigin: "https://example.com",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "disallowed origin",
+			cfg:        CORSConfig{AllowedOrigins: []string{"https://example.com"}},
+			method:     http.MethodGet,
+			origin:     "https://evil.example",
+			wantOrigin: "",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "wildcard origin",
+			cfg:        CORSConfig{AllowedOrigins: []string{"*"}},
+			method:     http.MethodGet,
+			origin:     "https://anything.example",
+			wantOrigin: "https://anything.example",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "preflight short-circuits the chain",
+			cfg:        CORSConfig{AllowedOrigins: []string{"*"}},
+			method:     http.MethodOptions,
+			origin:     "https://example.com",
+			wantOrigin: "https://example.com",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chained := middlewarechain.Chain(handler, CORS(tt.cfg))
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(tt.method, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			chained(w, r)
+
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+		})
+	}
+}
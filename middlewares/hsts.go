@@ -0,0 +1,26 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// HSTS returns a Middleware that sets Strict-Transport-Security on every
+// response, instructing browsers to only contact the origin over HTTPS for
+// maxAge. includeSubDomains adds the includeSubDomains directive.
+func HSTS(maxAge time.Duration, includeSubDomains bool) middlewarechain.Middleware {
+	value := fmt.Sprintf("max-age=%d", int(maxAge.Seconds()))
+	if includeSubDomains {
+		value += "; includeSubDomains"
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Strict-Transport-Security", value)
+			next(w, r)
+		}
+	}
+}
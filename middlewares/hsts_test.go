@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestHSTS(t *testing.T) {
+	tests := []struct {
+		name              string
+		maxAge            time.Duration
+		includeSubDomains bool
+		want              string
+	}{
+		{
+			name:   "max age only",
+			maxAge: 24 * time.Hour,
+			want:   "max-age=86400",
+		},
+		{
+			name:              "with subdomains",
+			maxAge:            time.Hour,
+			includeSubDomains: true,
+			want:              "max-age=3600; includeSubDomains",
+		},
+	}
+
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chained := middlewarechain.Chain(handler, HSTS(tt.maxAge, tt.includeSubDomains))
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			chained(w, r)
+
+			if got := w.Header().Get("Strict-Transport-Security"); got != tt.want {
+				t.Errorf("Strict-Transport-Security = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// AccessLog returns a Middleware that logs a structured start line and end
+// line (with the resulting status code and latency) for every request using
+// the given slog.Logger.
+func AccessLog(logger *slog.Logger) middlewarechain.Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			logger.Info("request started", "method", r.Method, "path", r.URL.Path)
+
+			start := time.Now()
+			rec := middlewarechain.NewResponseRecorder(w)
+			next(rec, r)
+
+			logger.Info("request completed",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rec.Status(),
+				"bytes", rec.BytesWritten(),
+				"latency", time.Since(start),
+			)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package middlewares
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestAccessLog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}
+	chained := middlewarechain.Chain(handler, AccessLog(logger))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/brew", nil)
+	chained(w, r)
+
+	out := buf.String()
+	if !strings.Contains(out, "request started") {
+		t.Errorf("log output missing start line: %q", out)
+	}
+	if !strings.Contains(out, "request completed") {
+		t.Errorf("log output missing end line: %q", out)
+	}
+	if !strings.Contains(out, "status=418") {
+		t.Errorf("log output missing captured status: %q", out)
+	}
+}
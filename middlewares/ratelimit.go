@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// tokenBucket is a single caller's bucket.
+type tokenBucket struct {
+	mu       sync.Mutex
+	tokens   float64
+	lastSeen time.Time
+}
+
+// RateLimiter returns a Middleware implementing a token-bucket rate limiter,
+// keyed per-caller by keyFunc (e.g. client IP, API key, user ID). Each key
+// refills at ratePerSecond tokens per second up to burst tokens, and requests
+// that arrive with no tokens available receive a 429 response.
+func RateLimiter(ratePerSecond float64, burst int, keyFunc func(*http.Request) string) middlewarechain.Middleware {
+	var mu sync.Mutex
+	buckets := make(map[string]*tokenBucket)
+
+	getBucket := func(key string) *tokenBucket {
+		mu.Lock()
+		defer mu.Unlock()
+
+		b, ok := buckets[key]
+		if !ok {
+			b = &tokenBucket{tokens: float64(burst), lastSeen: time.Now()}
+			buckets[key] = b
+		}
+		return b
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			b := getBucket(keyFunc(r))
+
+			b.mu.Lock()
+			now := time.Now()
+			elapsed := now.Sub(b.lastSeen).Seconds()
+			b.lastSeen = now
+			b.tokens = min(float64(burst), b.tokens+elapsed*ratePerSecond)
+
+			allowed := b.tokens >= 1
+			if allowed {
+				b.tokens--
+			}
+			b.mu.Unlock()
+
+			if !allowed {
+				http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+				return
+			}
+			next(w, r)
+		}
+	}
+}
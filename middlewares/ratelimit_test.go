@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestRateLimiter(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	keyFunc := func(r *http.Request) string { return r.RemoteAddr }
+	chained := middlewarechain.Chain(handler, RateLimiter(0, 2, keyFunc))
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "1.2.3.4:1111"
+		chained(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want %d", i, w.Code, http.StatusOK)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "1.2.3.4:1111"
+	chained(w, r)
+
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("status after exhausting burst = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimiterKeysAreIndependent(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	keyFunc := func(r *http.Request) string { return r.RemoteAddr }
+	chained := middlewarechain.Chain(handler, RateLimiter(0, 1, keyFunc))
+
+	for _, addr := range []string{"1.1.1.1:1", "2.2.2.2:2"} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = addr
+		chained(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("addr %s: status = %d, want %d", addr, w.Code, http.StatusOK)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// ErrorHandler handles a panic recovered from a downstream handler.
+type ErrorHandler func(w http.ResponseWriter, r *http.Request, recovered any)
+
+// DefaultErrorHandler writes a plain 500 response and discards the panic
+// value. It is used by Recovery when no ErrorHandler is supplied.
+func DefaultErrorHandler(w http.ResponseWriter, _ *http.Request, _ any) {
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// Recovery returns a Middleware that recovers panics from downstream
+// handlers and reports them via onError. A nil onError falls back to
+// DefaultErrorHandler.
+func Recovery(onError ErrorHandler) middlewarechain.Middleware {
+	if onError == nil {
+		onError = DefaultErrorHandler
+	}
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					onError(w, r, recovered)
+				}
+			}()
+			next(w, r)
+		}
+	}
+}
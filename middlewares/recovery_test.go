@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestRecovery(t *testing.T) {
+	tests := []struct {
+		name       string
+		onError    ErrorHandler
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "default handler on nil",
+			onError:    nil,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "Internal Server Error\n",
+		},
+		{
+			name: "custom handler",
+			onError: func(w http.ResponseWriter, _ *http.Request, recovered any) {
+				http.Error(w, "custom: boom", http.StatusBadGateway)
+			},
+			wantStatus: http.StatusBadGateway,
+			wantBody:   "custom: boom\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, _ *http.Request) {
+				panic("boom")
+			}
+			chained := middlewarechain.Chain(handler, Recovery(tt.onError))
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			chained(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if w.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestRecoveryDoesNotInterfereWithoutPanic(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	chained := middlewarechain.Chain(handler, Recovery(nil))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	chained(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,51 @@
+// Package middlewares provides a set of production-ready middlewares built
+// on top of the middlewarechain package.
+package middlewares
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+// RequestIDHeader is the header used to propagate and echo the correlation ID.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// RequestID returns a Middleware that assigns each request a correlation ID:
+// it reuses an inbound X-Request-ID header if present, otherwise generates a
+// random one, stores it in the request context, and echoes it back on the
+// response so callers and logs can correlate a request end-to-end.
+func RequestID() middlewarechain.Middleware {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			if id == "" {
+				id = newRequestID()
+			}
+
+			w.Header().Set(RequestIDHeader, id)
+			ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+			next(w, r.WithContext(ctx))
+		}
+	}
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or "" if
+// none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(buf)
+}
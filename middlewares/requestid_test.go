@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bbcoder-gh/middlewarechain"
+)
+
+func TestRequestID(t *testing.T) {
+	var gotFromContext string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		gotFromContext = RequestIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+
+	tests := []struct {
+		name      string
+		inboundID string
+		wantReuse bool
+	}{
+		{
+			name:      "generates an id when none supplied",
+			inboundID: "",
+			wantReuse: false,
+		},
+		{
+			name:      "reuses an inbound id",
+			inboundID: "abc-123",
+			wantReuse: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chained := middlewarechain.Chain(handler, RequestID())
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.inboundID != "" {
+				r.Header.Set(RequestIDHeader, tt.inboundID)
+			}
+			chained(w, r)
+
+			got := w.Header().Get(RequestIDHeader)
+			if got == "" {
+				t.Fatal("response is missing X-Request-ID header")
+			}
+			if tt.wantReuse && got != tt.inboundID {
+				t.Errorf("response id = %q, want reused inbound id %q", got, tt.inboundID)
+			}
+			if gotFromContext != got {
+				t.Errorf("context id = %q, want it to match response header %q", gotFromContext, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package middlewarechain
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+)
+
+// ResponseRecorder wraps an http.ResponseWriter to observe the status code
+// and byte count written to it, which the standard http.ResponseWriter does
+// not otherwise expose. This is the information most middlewares built on
+// top of a Chain need (access logging, metrics, compression, error pages).
+//
+// ResponseRecorder forwards http.Flusher, http.Hijacker, http.Pusher and
+// io.ReaderFrom to the wrapped writer when it implements them, so wrapping
+// does not silently break HTTP/2 push, WebSocket upgrades, or io.Copy fast
+// paths.
+type ResponseRecorder struct {
+	http.ResponseWriter
+	status       int
+	bytesWritten int64
+	written      bool
+}
+
+// NewResponseRecorder wraps w in a ResponseRecorder.
+func NewResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{ResponseWriter: w, status: http.StatusOK}
+}
+
+// Status returns the status code written, or http.StatusOK if WriteHeader
+// was never called explicitly.
+func (r *ResponseRecorder) Status() int {
+	return r.status
+}
+
+// BytesWritten returns the number of response body bytes written so far.
+func (r *ResponseRecorder) BytesWritten() int64 {
+	return r.bytesWritten
+}
+
+// Written reports whether a status code has been written to the underlying
+// http.ResponseWriter.
+func (r *ResponseRecorder) Written() bool {
+	return r.written
+}
+
+// WriteHeader records status and forwards it to the wrapped writer.
+func (r *ResponseRecorder) WriteHeader(status int) {
+	if r.written {
+		return
+	}
+	r.written = true
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the number of bytes written and forwards them to the
+// wrapped writer, implicitly writing the default status code first if
+// WriteHeader has not been called yet.
+func (r *ResponseRecorder) Write(p []byte) (int, error) {
+	if !r.written {
+		r.WriteHeader(http.StatusOK)
+	}
+	n, err := r.ResponseWriter.Write(p)
+	r.bytesWritten += int64(n)
+	return n, err
+}
+
+// Flush implements http.Flusher if the wrapped writer supports it.
+func (r *ResponseRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker if the wrapped writer supports it.
+func (r *ResponseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+	return h.Hijack()
+}
+
+// Push implements http.Pusher if the wrapped writer supports it.
+func (r *ResponseRecorder) Push(target string, opts *http.PushOptions) error {
+	p, ok := r.ResponseWriter.(http.Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+	return p.Push(target, opts)
+}
+
+// ReadFrom implements io.ReaderFrom, preserving the io.Copy fast path when
+// the wrapped writer supports it, while still tracking bytes written.
+func (r *ResponseRecorder) ReadFrom(src io.Reader) (int64, error) {
+	if !r.written {
+		r.WriteHeader(http.StatusOK)
+	}
+	rf, ok := r.ResponseWriter.(io.ReaderFrom)
+	if !ok {
+		n, err := io.Copy(writerOnly(r.ResponseWriter), src)
+		r.bytesWritten += n
+		return n, err
+	}
+	n, err := rf.ReadFrom(src)
+	r.bytesWritten += n
+	return n, err
+}
+
+// writerOnly hides any ReaderFrom implementation on w so io.Copy falls back
+// to explicit Write calls instead of recursing into ReadFrom.
+func writerOnly(w io.Writer) io.Writer {
+	return struct{ io.Writer }{w}
+}
+
+type responseRecorderKey struct{}
+
+// Capture returns a Middleware that installs a ResponseRecorder at this
+// point in the chain and makes it retrievable via ResponseRecorderFromContext,
+// so downstream middlewares in the same chain can read the final status and
+// byte count without each wrapping the writer themselves.
+func Capture(next Middleware) Middleware {
+	return func(h http.HandlerFunc) http.HandlerFunc {
+		wrapped := next(h)
+		return func(w http.ResponseWriter, r *http.Request) {
+			rec := NewResponseRecorder(w)
+			ctx := context.WithValue(r.Context(), responseRecorderKey{}, rec)
+			wrapped(rec, r.WithContext(ctx))
+		}
+	}
+}
+
+// ResponseRecorderFromContext returns the ResponseRecorder installed by
+// Capture, or nil if none is present.
+func ResponseRecorderFromContext(ctx context.Context) *ResponseRecorder {
+	rec, _ := ctx.Value(responseRecorderKey{}).(*ResponseRecorder)
+	return rec
+}
@@ -0,0 +1,79 @@
+package middlewarechain
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResponseRecorderCapturesStatusAndBytes(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("hello"))
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := NewResponseRecorder(w)
+	handler(rec, r)
+
+	if rec.Status() != http.StatusCreated {
+		t.Errorf("Status() = %d, want %d", rec.Status(), http.StatusCreated)
+	}
+	if rec.BytesWritten() != 5 {
+		t.Errorf("BytesWritten() = %d, want 5", rec.BytesWritten())
+	}
+	if !rec.Written() {
+		t.Error("Written() = false, want true")
+	}
+}
+
+func TestResponseRecorderDefaultsToOKWhenWriteHeaderNotCalled(t *testing.T) {
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("hi"))
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := NewResponseRecorder(w)
+	handler(rec, r)
+
+	if rec.Status() != http.StatusOK {
+		t.Errorf("Status() = %d, want %d", rec.Status(), http.StatusOK)
+	}
+}
+
+func TestCaptureExposesRecorderToDownstreamMiddleware(t *testing.T) {
+	var sawStatus int
+	readStatus := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			next(w, r)
+			if rec := ResponseRecorderFromContext(r.Context()); rec != nil {
+				sawStatus = rec.Status()
+			}
+		}
+	}
+
+	handler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}
+
+	chained := Chain(handler, Capture(readStatus))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	chained(w, r)
+
+	if sawStatus != http.StatusTeapot {
+		t.Errorf("status seen by downstream middleware = %d, want %d", sawStatus, http.StatusTeapot)
+	}
+	if w.Code != http.StatusTeapot {
+		t.Errorf("response status = %d, want %d", w.Code, http.StatusTeapot)
+	}
+}
+
+func TestResponseRecorderFromContextWithoutCapture(t *testing.T) {
+	if got := ResponseRecorderFromContext(httptest.NewRequest(http.MethodGet, "/", nil).Context()); got != nil {
+		t.Errorf("ResponseRecorderFromContext() = %v, want nil", got)
+	}
+}